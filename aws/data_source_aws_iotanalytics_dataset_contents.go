@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsIotAnalyticsDatasetContents() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIotAnalyticsDatasetContentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"dataset_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "$LATEST_SUCCEEDED",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"entries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entry_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIotAnalyticsDatasetContentsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	datasetName := d.Get("dataset_name").(string)
+	versionID := d.Get("version_id").(string)
+
+	input := &iotanalytics.GetDatasetContentInput{
+		DatasetName: aws.String(datasetName),
+		VersionId:   aws.String(versionID),
+	}
+
+	log.Printf("[DEBUG] Reading IoT Analytics Dataset (%s) content (%s)", datasetName, versionID)
+	output, err := conn.GetDatasetContent(input)
+
+	if isAWSErr(err, iotanalytics.ErrCodeResourceNotFoundException, "") {
+		_, describeErr := conn.DescribeDataset(&iotanalytics.DescribeDatasetInput{
+			DatasetName: aws.String(datasetName),
+		})
+
+		if isAWSErr(describeErr, iotanalytics.ErrCodeResourceNotFoundException, "") {
+			return fmt.Errorf("IoT Analytics Dataset (%s) not found: %s", datasetName, err)
+		}
+
+		if describeErr != nil {
+			return fmt.Errorf("error describing IoT Analytics Dataset (%s): %s", datasetName, describeErr)
+		}
+
+		return fmt.Errorf("no IoT Analytics Dataset (%s) content found for version %s, content may not have been generated yet", datasetName, versionID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Dataset (%s) content: %s", datasetName, err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading IoT Analytics Dataset (%s) content: empty response", datasetName)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", datasetName, versionID))
+
+	d.Set("status", "")
+	if output.Status != nil {
+		d.Set("status", output.Status.State)
+	}
+
+	d.Set("timestamp", "")
+	if output.Timestamp != nil {
+		d.Set("timestamp", aws.TimeValue(output.Timestamp).Format(time.RFC3339))
+	}
+
+	if err := d.Set("entries", flattenIotAnalyticsDatasetEntries(output.Entries)); err != nil {
+		return fmt.Errorf("error setting entries: %s", err)
+	}
+
+	return nil
+}
+
+func flattenIotAnalyticsDatasetEntries(entries []*iotanalytics.DatasetEntry) []interface{} {
+	result := make([]interface{}, 0, len(entries))
+
+	for _, entry := range entries {
+		if entry == nil {
+			continue
+		}
+
+		m := map[string]interface{}{
+			"entry_name": aws.StringValue(entry.EntryName),
+			"data_uri":   aws.StringValue(entry.DataURI),
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
@@ -0,0 +1,33 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsIotAnalyticsDatasetContents_DatasetNotFound(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(7))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSIoTAnalytics(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceAwsIotAnalyticsDatasetContentsConfig(rName),
+				ExpectError: regexp.MustCompile(`IoT Analytics Dataset \(.+\) not found`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsIotAnalyticsDatasetContentsConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_iotanalytics_dataset_contents" "test" {
+  dataset_name = %[1]q
+}
+`, rName)
+}
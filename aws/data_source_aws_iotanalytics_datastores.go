@@ -0,0 +1,74 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsIotAnalyticsDatastores() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIotAnalyticsDatastoresRead,
+
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIotAnalyticsDatastoresRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	var pages []*iotanalytics.ListDatastoresOutput
+	input := &iotanalytics.ListDatastoresInput{}
+
+	log.Printf("[DEBUG] Listing IoT Analytics Datastores")
+	err := conn.ListDatastoresPages(input, func(page *iotanalytics.ListDatastoresOutput, lastPage bool) bool {
+		pages = append(pages, page)
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing IoT Analytics Datastores: %s", err)
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	if err := d.Set("names", flattenIotAnalyticsDatastoreNames(pages)); err != nil {
+		return fmt.Errorf("error setting names: %s", err)
+	}
+
+	return nil
+}
+
+// flattenIotAnalyticsDatastoreNames merges the datastore names from every
+// ListDatastores page into a single sorted list.
+func flattenIotAnalyticsDatastoreNames(pages []*iotanalytics.ListDatastoresOutput) []string {
+	names := make([]string, 0)
+
+	for _, page := range pages {
+		if page == nil {
+			continue
+		}
+
+		for _, summary := range page.DatastoreSummaries {
+			if summary == nil {
+				continue
+			}
+
+			names = append(names, aws.StringValue(summary.DatastoreName))
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
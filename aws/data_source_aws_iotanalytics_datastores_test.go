@@ -0,0 +1,59 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestFlattenIotAnalyticsDatastoreNames(t *testing.T) {
+	pages := []*iotanalytics.ListDatastoresOutput{
+		{
+			DatastoreSummaries: []*iotanalytics.DatastoreSummary{
+				{DatastoreName: aws.String("datastore_c")},
+				{DatastoreName: aws.String("datastore_a")},
+			},
+			NextToken: aws.String("token"),
+		},
+		{
+			DatastoreSummaries: []*iotanalytics.DatastoreSummary{
+				{DatastoreName: aws.String("datastore_b")},
+			},
+		},
+	}
+
+	expected := []string{"datastore_a", "datastore_b", "datastore_c"}
+	names := flattenIotAnalyticsDatastoreNames(pages)
+
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected %v, received: %v", expected, names)
+	}
+
+	if names := flattenIotAnalyticsDatastoreNames(nil); len(names) != 0 {
+		t.Fatalf("expected no names, received: %v", names)
+	}
+}
+
+func TestAccDataSourceAwsIotAnalyticsDatastores_basic(t *testing.T) {
+	dataSourceName := "data.aws_iotanalytics_datastores.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSIoTAnalytics(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsIotAnalyticsDatastoresConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "names.#"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsIotAnalyticsDatastoresConfig = `
+data "aws_iotanalytics_datastores" "test" {}
+`
@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsIotAnalyticsPipelineReprocessing() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIotAnalyticsPipelineReprocessingRead,
+
+		Schema: map[string]*schema.Schema{
+			"pipeline_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"reprocessing_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsIotAnalyticsPipelineReprocessingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	pipelineName := d.Get("pipeline_name").(string)
+	reprocessingID := d.Get("reprocessing_id").(string)
+
+	input := &iotanalytics.DescribePipelineInput{
+		PipelineName: aws.String(pipelineName),
+	}
+
+	log.Printf("[DEBUG] Reading IoT Analytics Pipeline (%s)", pipelineName)
+	output, err := conn.DescribePipeline(input)
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Pipeline (%s): %s", pipelineName, err)
+	}
+
+	if output == nil || output.Pipeline == nil {
+		return fmt.Errorf("error reading IoT Analytics Pipeline (%s): empty response", pipelineName)
+	}
+
+	var summary *iotanalytics.ReprocessingSummary
+	for _, s := range output.Pipeline.ReprocessingSummaries {
+		if aws.StringValue(s.Id) == reprocessingID {
+			summary = s
+			break
+		}
+	}
+
+	if summary == nil {
+		return fmt.Errorf("no IoT Analytics Pipeline (%s) reprocessing found with ID: %s", pipelineName, reprocessingID)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", pipelineName, reprocessingID))
+	d.Set("status", summary.Status)
+	d.Set("creation_time", "")
+	if summary.CreationTime != nil {
+		d.Set("creation_time", aws.TimeValue(summary.CreationTime).Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsIotAnalyticsPipelineReprocessing_PipelineNotFound(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(7))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSIoTAnalytics(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceAwsIotAnalyticsPipelineReprocessingConfig(rName),
+				ExpectError: regexp.MustCompile(`error reading IoT Analytics Pipeline`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsIotAnalyticsPipelineReprocessingConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_iotanalytics_pipeline_reprocessing" "test" {
+  pipeline_name   = %[1]q
+  reprocessing_id = "00000000-0000-0000-0000-000000000000"
+}
+`, rName)
+}
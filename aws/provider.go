@@ -215,6 +215,9 @@ func Provider() terraform.ResourceProvider {
 			"aws_iam_user":                                  dataSourceAwsIAMUser(),
 			"aws_internet_gateway":                          dataSourceAwsInternetGateway(),
 			"aws_iot_endpoint":                              dataSourceAwsIotEndpoint(),
+			"aws_iotanalytics_dataset_contents":             dataSourceAwsIotAnalyticsDatasetContents(),
+			"aws_iotanalytics_datastores":                   dataSourceAwsIotAnalyticsDatastores(),
+			"aws_iotanalytics_pipeline_reprocessing":        dataSourceAwsIotAnalyticsPipelineReprocessing(),
 			"aws_inspector_rules_packages":                  dataSourceAwsInspectorRulesPackages(),
 			"aws_instance":                                  dataSourceAwsInstance(),
 			"aws_instances":                                 dataSourceAwsInstances(),
@@ -558,6 +561,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_iot_thing_type":                                      resourceAwsIotThingType(),
 			"aws_iot_topic_rule":                                      resourceAwsIotTopicRule(),
 			"aws_iot_role_alias":                                      resourceAwsIotRoleAlias(),
+			"aws_iotanalytics_dataset_content":                        resourceAwsIotAnalyticsDatasetContent(),
 			"aws_key_pair":                                            resourceAwsKeyPair(),
 			"aws_kinesis_firehose_delivery_stream":                    resourceAwsKinesisFirehoseDeliveryStream(),
 			"aws_kinesis_stream":                                      resourceAwsKinesisStream(),
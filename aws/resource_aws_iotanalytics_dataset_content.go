@@ -0,0 +1,228 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsIotAnalyticsDatasetContent() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotAnalyticsDatasetContentCreate,
+		Read:   resourceAwsIotAnalyticsDatasetContentRead,
+		Delete: resourceAwsIotAnalyticsDatasetContentDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dataset_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_data_uris": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAwsIotAnalyticsDatasetContentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	datasetName := d.Get("dataset_name").(string)
+
+	input := &iotanalytics.CreateDatasetContentInput{
+		DatasetName: aws.String(datasetName),
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Dataset (%s) content", datasetName)
+	output, err := conn.CreateDatasetContent(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Analytics Dataset (%s) content: %s", datasetName, err)
+	}
+
+	versionID := aws.StringValue(output.VersionId)
+
+	if versionID == "" {
+		return fmt.Errorf("error creating IoT Analytics Dataset (%s) content: empty version ID in response", datasetName)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", datasetName, versionID))
+
+	if d.Get("wait_for_completion").(bool) {
+		if err := waitForIotAnalyticsDatasetContentCreation(conn, datasetName, versionID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for IoT Analytics Dataset (%s) content (%s) creation: %s", datasetName, versionID, err)
+		}
+	}
+
+	return resourceAwsIotAnalyticsDatasetContentRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsDatasetContentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	datasetName, versionID, err := decodeIotAnalyticsDatasetContentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &iotanalytics.GetDatasetContentInput{
+		DatasetName: aws.String(datasetName),
+		VersionId:   aws.String(versionID),
+	}
+
+	log.Printf("[DEBUG] Reading IoT Analytics Dataset Content (%s)", d.Id())
+	output, err := conn.GetDatasetContent(input)
+
+	if isAWSErr(err, iotanalytics.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] IoT Analytics Dataset Content (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Dataset Content (%s): %s", d.Id(), err)
+	}
+
+	d.Set("dataset_name", datasetName)
+	d.Set("version_id", versionID)
+
+	d.Set("content_status", "")
+	d.Set("content_status_reason", "")
+	if output.Status != nil {
+		d.Set("content_status", output.Status.State)
+		d.Set("content_status_reason", output.Status.Reason)
+	}
+
+	var dataURIs []string
+	for _, entry := range output.Entries {
+		dataURIs = append(dataURIs, aws.StringValue(entry.DataURI))
+	}
+	if err := d.Set("content_data_uris", dataURIs); err != nil {
+		return fmt.Errorf("error setting content_data_uris: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotAnalyticsDatasetContentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	datasetName, versionID, err := decodeIotAnalyticsDatasetContentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &iotanalytics.DeleteDatasetContentInput{
+		DatasetName: aws.String(datasetName),
+		VersionId:   aws.String(versionID),
+	}
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Dataset Content (%s)", d.Id())
+	_, err = conn.DeleteDatasetContent(input)
+
+	if isAWSErr(err, iotanalytics.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Analytics Dataset Content (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeIotAnalyticsDatasetContentID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%s), expected DATASET_NAME:VERSION_ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func iotAnalyticsDatasetContentRefreshFunc(conn *iotanalytics.IoTAnalytics, datasetName, versionID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &iotanalytics.GetDatasetContentInput{
+			DatasetName: aws.String(datasetName),
+			VersionId:   aws.String(versionID),
+		}
+
+		output, err := conn.GetDatasetContent(input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.Status == nil {
+			return nil, "", nil
+		}
+
+		state, err := iotAnalyticsDatasetContentState(output)
+
+		return output, state, err
+	}
+}
+
+// iotAnalyticsDatasetContentState returns the state of the dataset content,
+// along with an error containing the status reason if generation failed.
+func iotAnalyticsDatasetContentState(output *iotanalytics.GetDatasetContentOutput) (string, error) {
+	if output == nil || output.Status == nil {
+		return "", nil
+	}
+
+	state := aws.StringValue(output.Status.State)
+
+	if state == iotanalytics.DatasetContentStateFailed {
+		return state, fmt.Errorf("content generation failed: %s", aws.StringValue(output.Status.Reason))
+	}
+
+	return state, nil
+}
+
+func waitForIotAnalyticsDatasetContentCreation(conn *iotanalytics.IoTAnalytics, datasetName, versionID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotanalytics.DatasetContentStateCreating},
+		Target:  []string{iotanalytics.DatasetContentStateSucceeded},
+		Refresh: iotAnalyticsDatasetContentRefreshFunc(conn, datasetName, versionID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for IoT Analytics Dataset (%s) content (%s) creation", datasetName, versionID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
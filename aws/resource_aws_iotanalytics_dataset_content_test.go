@@ -0,0 +1,193 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeIotAnalyticsDatasetContentID(t *testing.T) {
+	testCases := []struct {
+		Input               string
+		ExpectedDatasetName string
+		ExpectedVersionID   string
+		ErrCount            int
+	}{
+		{
+			Input:    "",
+			ErrCount: 1,
+		},
+		{
+			Input:    "example",
+			ErrCount: 1,
+		},
+		{
+			Input:    "example:",
+			ErrCount: 1,
+		},
+		{
+			Input:               "example:4a4d5e1b-4a6e-4b2e-9c6d-0f1a2b3c4d5e",
+			ExpectedDatasetName: "example",
+			ExpectedVersionID:   "4a4d5e1b-4a6e-4b2e-9c6d-0f1a2b3c4d5e",
+			ErrCount:            0,
+		},
+	}
+
+	for _, tc := range testCases {
+		datasetName, versionID, err := decodeIotAnalyticsDatasetContentID(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if datasetName != tc.ExpectedDatasetName {
+			t.Fatalf("expected %q to return dataset name %q, received: %q", tc.Input, tc.ExpectedDatasetName, datasetName)
+		}
+		if versionID != tc.ExpectedVersionID {
+			t.Fatalf("expected %q to return version ID %q, received: %q", tc.Input, tc.ExpectedVersionID, versionID)
+		}
+	}
+}
+
+func TestIotAnalyticsDatasetContentState(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Output        *iotanalytics.GetDatasetContentOutput
+		ExpectedState string
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			Name:   "nil Status",
+			Output: &iotanalytics.GetDatasetContentOutput{},
+		},
+		{
+			Name: "CREATING",
+			Output: &iotanalytics.GetDatasetContentOutput{
+				Status: &iotanalytics.DatasetContentStatus{
+					State: aws.String(iotanalytics.DatasetContentStateCreating),
+				},
+			},
+			ExpectedState: iotanalytics.DatasetContentStateCreating,
+		},
+		{
+			Name: "SUCCEEDED",
+			Output: &iotanalytics.GetDatasetContentOutput{
+				Status: &iotanalytics.DatasetContentStatus{
+					State: aws.String(iotanalytics.DatasetContentStateSucceeded),
+				},
+			},
+			ExpectedState: iotanalytics.DatasetContentStateSucceeded,
+		},
+		{
+			Name: "FAILED",
+			Output: &iotanalytics.GetDatasetContentOutput{
+				Status: &iotanalytics.DatasetContentStatus{
+					State:  aws.String(iotanalytics.DatasetContentStateFailed),
+					Reason: aws.String("Query execution failed: table not found"),
+				},
+			},
+			ExpectedState: iotanalytics.DatasetContentStateFailed,
+			ExpectedError: regexp.MustCompile(`content generation failed: Query execution failed: table not found`),
+		},
+	}
+
+	for _, tc := range testCases {
+		state, err := iotAnalyticsDatasetContentState(tc.Output)
+
+		if tc.ExpectedError == nil && err != nil {
+			t.Fatalf("%s: expected no error, received: %s", tc.Name, err)
+		}
+		if tc.ExpectedError != nil {
+			if err == nil {
+				t.Fatalf("%s: expected error matching %q", tc.Name, tc.ExpectedError)
+			}
+			if !tc.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("%s: expected error matching %q, received: %s", tc.Name, tc.ExpectedError, err)
+			}
+		}
+		if state != tc.ExpectedState {
+			t.Fatalf("%s: expected state %q, received: %q", tc.Name, tc.ExpectedState, state)
+		}
+	}
+}
+
+func TestAccAWSIotAnalyticsDatasetContent_DatasetNotFound(t *testing.T) {
+	rName := fmt.Sprintf("tf_acc_test_%s", acctest.RandString(7))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIoTAnalytics(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsDatasetContentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSIotAnalyticsDatasetContentConfig(rName),
+				ExpectError: regexp.MustCompile(`error creating IoT Analytics Dataset`),
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSIoTAnalytics(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+	input := &iotanalytics.ListChannelsInput{
+		MaxResults: aws.Int64(int64(1)),
+	}
+
+	_, err := conn.ListChannels(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckAWSIotAnalyticsDatasetContentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotanalytics_dataset_content" {
+			continue
+		}
+
+		datasetName, versionID, err := decodeIotAnalyticsDatasetContentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetDatasetContent(&iotanalytics.GetDatasetContentInput{
+			DatasetName: aws.String(datasetName),
+			VersionId:   aws.String(versionID),
+		})
+
+		if isAWSErr(err, iotanalytics.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IoT Analytics Dataset Content (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSIotAnalyticsDatasetContentConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_dataset_content" "test" {
+  dataset_name = %[1]q
+}
+`, rName)
+}